@@ -0,0 +1,40 @@
+package multimodal
+
+import (
+	"context"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// Backend abstracts the multimodal model provider that MultiModal talks to.
+// When no backend is configured (the zero value, used by New), Submit and
+// friends talk to Vertex AI directly as before. NewWithBackend lets callers
+// swap in Google AI Studio, an OpenAI-compatible endpoint, or any other
+// implementation, removing the hard dependency on a GCP project for callers
+// who just want to point at a self-hosted or API-key-based model.
+//
+// All three methods accept the same vertexai/genai part types used
+// throughout this package (genai.Text, genai.Blob, genai.FileData);
+// implementations that talk to a different SDK convert internally.
+type Backend interface {
+	// GenerateContent sends parts to the model and returns the assembled
+	// text response.
+	GenerateContent(ctx context.Context, parts ...genai.Part) (string, error)
+	// CountTokens returns the token count of the given parts.
+	CountTokens(ctx context.Context, parts ...genai.Part) (int, error)
+	// StreamContent streams back incremental text chunks as they are
+	// generated. Both channels are closed once the stream ends, and at
+	// most one error is ever sent on the error channel.
+	StreamContent(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error)
+}
+
+// NewWithBackend creates a new MultiModal instance that dispatches Submit,
+// CountTokens and the streaming variants through the given Backend instead
+// of creating a Vertex AI client directly. projectID/location parameters on
+// Submit and CountTokens are ignored in this mode, since the backend is
+// already fully configured.
+func NewWithBackend(modelName string, temperature float32, backend Backend) *MultiModal {
+	mm := New(modelName, temperature)
+	mm.backend = backend
+	return mm
+}
@@ -0,0 +1,76 @@
+package multimodal
+
+import (
+	"reflect"
+	"testing"
+
+	"cloud.google.com/go/vertexai/genai"
+	studiogenai "github.com/google/generative-ai-go/genai"
+)
+
+func TestToStudioPart(t *testing.T) {
+	cases := []struct {
+		name string
+		in   genai.Part
+		want studiogenai.Part
+	}{
+		{
+			name: "text",
+			in:   genai.Text("hello"),
+			want: studiogenai.Text("hello"),
+		},
+		{
+			name: "blob",
+			in:   genai.Blob{MIMEType: "image/png", Data: []byte{1, 2, 3}},
+			want: studiogenai.Blob{MIMEType: "image/png", Data: []byte{1, 2, 3}},
+		},
+		{
+			name: "file data",
+			in:   genai.FileData{MIMEType: "image/png", FileURI: "gs://bucket/object.png"},
+			want: studiogenai.FileData{MIMEType: "image/png", URI: "gs://bucket/object.png"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := toStudioPart(c.in)
+			if err != nil {
+				t.Fatalf("toStudioPart returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("toStudioPart(%v) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestToStudioPartUnsupported(t *testing.T) {
+	if _, err := toStudioPart(genai.FunctionResponse{}); err == nil {
+		t.Error("expected an error for an unsupported part type, got nil")
+	}
+}
+
+func TestToOpenAIContent(t *testing.T) {
+	parts := []genai.Part{
+		genai.Text("describe this image"),
+		genai.FileData{MIMEType: "image/png", FileURI: "https://example.com/image.png"},
+	}
+	content, err := toOpenAIContent(parts)
+	if err != nil {
+		t.Fatalf("toOpenAIContent returned error: %v", err)
+	}
+	if len(content) != 2 {
+		t.Fatalf("got %d content parts, want 2", len(content))
+	}
+	if content[0].Type != "text" || content[0].Text != "describe this image" {
+		t.Errorf("unexpected text part: %+v", content[0])
+	}
+	if content[1].Type != "image_url" || content[1].ImageURL == nil || content[1].ImageURL.URL != "https://example.com/image.png" {
+		t.Errorf("unexpected image_url part: %+v", content[1])
+	}
+}
+
+func TestToOpenAIContentUnsupported(t *testing.T) {
+	if _, err := toOpenAIContent([]genai.Part{genai.FunctionResponse{}}); err == nil {
+		t.Error("expected an error for an unsupported part type, got nil")
+	}
+}
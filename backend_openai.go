@@ -0,0 +1,225 @@
+package multimodal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// openAIBackend talks to any OpenAI-compatible chat completions endpoint
+// (OpenAI itself, LocalAI, Ollama, ...) over plain HTTP, so this package
+// does not need to depend on an official OpenAI SDK.
+type openAIBackend struct {
+	baseURL     string
+	apiKey      string
+	modelName   string
+	temperature float32
+	httpClient  *http.Client
+}
+
+// NewOpenAIBackend creates a Backend that talks to an OpenAI-compatible
+// /chat/completions endpoint at baseURL, such as "https://api.openai.com/v1"
+// or a local "http://localhost:11434/v1" for Ollama. apiKey may be empty for
+// endpoints that don't require authentication.
+func NewOpenAIBackend(baseURL, apiKey, modelName string, temperature float32) Backend {
+	return &openAIBackend{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		apiKey:      apiKey,
+		modelName:   modelName,
+		temperature: temperature,
+		httpClient:  http.DefaultClient,
+	}
+}
+
+type openAIImageURL struct {
+	URL string `json:"url"`
+}
+
+type openAIContentPart struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	ImageURL *openAIImageURL `json:"image_url,omitempty"`
+}
+
+type openAIChatMessage struct {
+	Role    string              `json:"role"`
+	Content []openAIContentPart `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model       string              `json:"model"`
+	Temperature float32             `json:"temperature"`
+	Stream      bool                `json:"stream,omitempty"`
+	Messages    []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+}
+
+type openAIChatStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// toOpenAIContent converts this package's vertexai/genai parts into the
+// content-part array of an OpenAI chat message. genai.Blob images are
+// inlined as base64 data URLs; genai.FileData is passed through as-is,
+// since OpenAI-compatible endpoints accept arbitrary image URLs.
+func toOpenAIContent(parts []genai.Part) ([]openAIContentPart, error) {
+	content := make([]openAIContentPart, 0, len(parts))
+	for _, part := range parts {
+		switch p := part.(type) {
+		case genai.Text:
+			content = append(content, openAIContentPart{Type: "text", Text: string(p)})
+		case genai.Blob:
+			encoded := base64.StdEncoding.EncodeToString(p.Data)
+			content = append(content, openAIContentPart{
+				Type:     "image_url",
+				ImageURL: &openAIImageURL{URL: fmt.Sprintf("data:%s;base64,%s", p.MIMEType, encoded)},
+			})
+		case genai.FileData:
+			content = append(content, openAIContentPart{
+				Type:     "image_url",
+				ImageURL: &openAIImageURL{URL: p.FileURI},
+			})
+		default:
+			return nil, fmt.Errorf("unsupported part type for the OpenAI-compatible backend: %T", part)
+		}
+	}
+	return content, nil
+}
+
+func (b *openAIBackend) do(ctx context.Context, req openAIChatRequest) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode request: %v", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if b.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+b.apiKey)
+	}
+	resp, err := b.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach %s: %v", b.baseURL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("bad status from %s: %s", b.baseURL, resp.Status)
+	}
+	return resp, nil
+}
+
+func (b *openAIBackend) GenerateContent(ctx context.Context, parts ...genai.Part) (string, error) {
+	content, err := toOpenAIContent(parts)
+	if err != nil {
+		return "", err
+	}
+	resp, err := b.do(ctx, openAIChatRequest{
+		Model:       b.modelName,
+		Temperature: b.temperature,
+		Messages:    []openAIChatMessage{{Role: "user", Content: content}},
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result openAIChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("unable to decode response: %v", err)
+	}
+	if len(result.Choices) == 0 {
+		return "", errors.New("empty response from model")
+	}
+	return result.Choices[0].Message.Content, nil
+}
+
+// CountTokens is not supported by the OpenAI chat completions API, which has
+// no general-purpose token-counting endpoint across implementations.
+func (b *openAIBackend) CountTokens(ctx context.Context, parts ...genai.Part) (int, error) {
+	return 0, errors.New("token counting is not supported by the OpenAI-compatible backend")
+}
+
+func (b *openAIBackend) StreamContent(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+
+		content, err := toOpenAIContent(parts)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		resp, err := b.do(ctx, openAIChatRequest{
+			Model:       b.modelName,
+			Temperature: b.temperature,
+			Stream:      true,
+			Messages:    []openAIChatMessage{{Role: "user", Content: content}},
+		})
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer resp.Body.Close()
+
+		var received bool
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				break
+			}
+			var chunk openAIChatStreamChunk
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				errCh <- fmt.Errorf("unable to decode stream chunk: %v", err)
+				return
+			}
+			if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+				continue
+			}
+			received = true
+			select {
+			case chunks <- chunk.Choices[0].Delta.Content:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			errCh <- fmt.Errorf("unable to read stream: %v", err)
+			return
+		}
+		if !received {
+			errCh <- errors.New("empty response from model")
+		}
+	}()
+
+	return chunks, errCh
+}
@@ -0,0 +1,40 @@
+package multimodal
+
+import (
+	"context"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// stubBackend is a minimal Backend used across this package's tests to
+// exercise backend-dispatch code paths without talking to any real API.
+type stubBackend struct {
+	text      string
+	genErr    error
+	tokens    int
+	tokensErr error
+	chunks    []string
+	streamErr error
+}
+
+func (s *stubBackend) GenerateContent(ctx context.Context, parts ...genai.Part) (string, error) {
+	return s.text, s.genErr
+}
+
+func (s *stubBackend) CountTokens(ctx context.Context, parts ...genai.Part) (int, error) {
+	return s.tokens, s.tokensErr
+}
+
+func (s *stubBackend) StreamContent(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error) {
+	chunks := make(chan string, len(s.chunks))
+	errCh := make(chan error, 1)
+	for _, c := range s.chunks {
+		chunks <- c
+	}
+	close(chunks)
+	if s.streamErr != nil {
+		errCh <- s.streamErr
+	}
+	close(errCh)
+	return chunks, errCh
+}
@@ -0,0 +1,156 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+	studiogenai "github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// studioBackend talks to Google AI Studio (the API-key based
+// github.com/google/generative-ai-go/genai client) instead of Vertex AI, so
+// hobbyist callers without a GCP project can still use this package.
+type studioBackend struct {
+	client      *studiogenai.Client
+	modelName   string
+	temperature float32
+}
+
+// NewStudioBackend creates a Backend that talks to Google AI Studio using
+// the given API key, obtained from https://aistudio.google.com/app/apikey.
+func NewStudioBackend(ctx context.Context, apiKey, modelName string, temperature float32) (Backend, error) {
+	client, err := studiogenai.NewClient(ctx, option.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google AI Studio client: %v", err)
+	}
+	return &studioBackend{client: client, modelName: modelName, temperature: temperature}, nil
+}
+
+func (b *studioBackend) model() *studiogenai.GenerativeModel {
+	model := b.client.GenerativeModel(b.modelName)
+	model.SetTemperature(b.temperature)
+	return model
+}
+
+// toStudioPart converts one of this package's vertexai/genai parts into the
+// equivalent Google AI Studio part. The two SDKs model parts almost
+// identically, but as distinct Go types, so they can't be used interchangeably.
+func toStudioPart(part genai.Part) (studiogenai.Part, error) {
+	switch p := part.(type) {
+	case genai.Text:
+		return studiogenai.Text(p), nil
+	case genai.Blob:
+		return studiogenai.Blob{MIMEType: p.MIMEType, Data: p.Data}, nil
+	case genai.FileData:
+		return studiogenai.FileData{MIMEType: p.MIMEType, URI: p.FileURI}, nil
+	default:
+		return nil, fmt.Errorf("unsupported part type for the Google AI Studio backend: %T", part)
+	}
+}
+
+func (b *studioBackend) convertParts(parts []genai.Part) ([]studiogenai.Part, error) {
+	converted := make([]studiogenai.Part, len(parts))
+	for i, part := range parts {
+		studioPart, err := toStudioPart(part)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = studioPart
+	}
+	return converted, nil
+}
+
+// studioResponseParts returns the parts of a Google AI Studio
+// GenerateContentResponse's first candidate, and false if the response is
+// empty or missing content.
+func studioResponseParts(res *studiogenai.GenerateContentResponse) ([]studiogenai.Part, bool) {
+	if res == nil || len(res.Candidates) == 0 || res.Candidates[0] == nil ||
+		res.Candidates[0].Content == nil || len(res.Candidates[0].Content.Parts) == 0 {
+		return nil, false
+	}
+	return res.Candidates[0].Content.Parts, true
+}
+
+func (b *studioBackend) GenerateContent(ctx context.Context, parts ...genai.Part) (string, error) {
+	studioParts, err := b.convertParts(parts)
+	if err != nil {
+		return "", err
+	}
+	res, err := b.model().GenerateContent(ctx, studioParts...)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate contents: %v", err)
+	}
+	respParts, ok := studioResponseParts(res)
+	if !ok {
+		return "", errors.New("empty response from model")
+	}
+	return fmt.Sprintf("%s\n", respParts[0]), nil
+}
+
+func (b *studioBackend) CountTokens(ctx context.Context, parts ...genai.Part) (int, error) {
+	studioParts, err := b.convertParts(parts)
+	if err != nil {
+		return 0, err
+	}
+	model := b.model()
+	var sum int
+	for _, part := range studioParts {
+		resp, err := model.CountTokens(ctx, part)
+		if err != nil {
+			return sum, err
+		}
+		sum += int(resp.TotalTokens)
+	}
+	return sum, nil
+}
+
+func (b *studioBackend) StreamContent(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+
+		studioParts, err := b.convertParts(parts)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		iter := b.model().GenerateContentStream(ctx, studioParts...)
+		var full strings.Builder
+		for {
+			res, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				errCh <- fmt.Errorf("unable to generate contents: %v", err)
+				return
+			}
+			respParts, ok := studioResponseParts(res)
+			if !ok {
+				continue
+			}
+			text := fmt.Sprintf("%s", respParts[0])
+			full.WriteString(text)
+			select {
+			case chunks <- text:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+		if full.Len() == 0 {
+			errCh <- errors.New("empty response from model")
+		}
+	}()
+
+	return chunks, errCh
+}
@@ -0,0 +1,98 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+	"google.golang.org/api/iterator"
+)
+
+// vertexBackend is a Backend wrapping an already-created Vertex AI client.
+// A MultiModal created with New does not use it: with no backend configured,
+// Submit and friends call the Vertex AI client they are given directly.
+type vertexBackend struct {
+	client      *genai.Client
+	modelName   string
+	temperature float32
+}
+
+// NewVertexBackend wraps an existing Vertex AI client as a Backend, for
+// callers that want to go through the Backend abstraction (for example to
+// share code paths with other backends) while still talking to Vertex AI.
+func NewVertexBackend(client *genai.Client, modelName string, temperature float32) Backend {
+	return &vertexBackend{client: client, modelName: modelName, temperature: temperature}
+}
+
+func (b *vertexBackend) model() *genai.GenerativeModel {
+	model := b.client.GenerativeModel(b.modelName)
+	model.SetTemperature(b.temperature)
+	return model
+}
+
+func (b *vertexBackend) GenerateContent(ctx context.Context, parts ...genai.Part) (string, error) {
+	res, err := b.model().GenerateContent(ctx, parts...)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate contents: %v", err)
+	}
+	respParts, ok := responseParts(res)
+	if !ok {
+		return "", errors.New("empty response from model")
+	}
+	return fmt.Sprintf("%s\n", respParts[0]), nil
+}
+
+func (b *vertexBackend) CountTokens(ctx context.Context, parts ...genai.Part) (int, error) {
+	model := b.model()
+	var sum int
+	for _, part := range parts {
+		resp, err := model.CountTokens(ctx, part)
+		if err != nil {
+			return sum, err
+		}
+		sum += int(resp.TotalTokens)
+	}
+	return sum, nil
+}
+
+func (b *vertexBackend) StreamContent(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+
+		iter := b.model().GenerateContentStream(ctx, parts...)
+		var full strings.Builder
+		for {
+			res, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				errCh <- fmt.Errorf("unable to generate contents: %v", err)
+				return
+			}
+			respParts, ok := responseParts(res)
+			if !ok {
+				continue
+			}
+			text := fmt.Sprintf("%s", respParts[0])
+			full.WriteString(text)
+			select {
+			case chunks <- text:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+		if full.Len() == 0 {
+			errCh <- errors.New("empty response from model")
+		}
+	}()
+
+	return chunks, errCh
+}
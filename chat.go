@@ -0,0 +1,121 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+	"google.golang.org/api/iterator"
+)
+
+// Chat wraps a genai.ChatSession, letting callers hold a conversation
+// across multiple turns instead of rebuilding parts for every Submit.
+type Chat struct {
+	mm      *MultiModal
+	session *genai.ChatSession
+}
+
+// StartChat creates a new Chat bound to the given client, using the
+// MultiModal instance's model name, temperature and generation-config
+// settings. Any parts already added to mm (for example a system image) are
+// not part of the chat history; add turns with SendMessage instead.
+// StartChat is not supported when the MultiModal instance was created with
+// NewWithBackend, since the Backend interface has no notion of a chat
+// session; it returns an error in that case instead of dereferencing the
+// nil client.
+func (mm *MultiModal) StartChat(client *genai.Client) (*Chat, error) {
+	if mm.backend != nil {
+		return nil, errors.New("chat sessions are not supported with a configured Backend")
+	}
+	model := client.GenerativeModel(mm.modelName)
+	mm.applyGenerationConfig(model)
+	return &Chat{
+		mm:      mm,
+		session: model.StartChat(),
+	}, nil
+}
+
+// History returns the chat's accumulated turns.
+func (c *Chat) History() []*genai.Content {
+	return c.session.History
+}
+
+// SetHistory replaces the chat's accumulated turns, for example to restore
+// a conversation that was persisted earlier.
+func (c *Chat) SetHistory(history []*genai.Content) {
+	c.session.History = history
+}
+
+// Reset clears the chat history, starting the conversation over.
+func (c *Chat) Reset() {
+	c.session.History = nil
+}
+
+// SendMessage sends one turn of the conversation and returns the model's
+// reply as text, honoring the MultiModal instance's trim flag. The turn is
+// appended to History on success.
+func (c *Chat) SendMessage(ctx context.Context, parts ...genai.Part) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+	res, err := c.session.SendMessage(ctx, parts...)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate contents: %v", err)
+	}
+	respParts, ok := responseParts(res)
+	if !ok {
+		return "", errors.New("empty response from model")
+	}
+	result = fmt.Sprintf("%s\n", respParts[0])
+	if c.mm.trim {
+		return strings.TrimSpace(result), nil
+	}
+	return result, nil
+}
+
+// SendMessageStream sends one turn of the conversation and streams back
+// incremental text chunks as they are generated. Both returned channels are
+// closed once the turn ends, and the turn is appended to History on success.
+func (c *Chat) SendMessageStream(ctx context.Context, parts ...genai.Part) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+
+		iter := c.session.SendMessageStream(ctx, parts...)
+		var full strings.Builder
+		for {
+			res, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				errCh <- fmt.Errorf("unable to generate contents: %v", err)
+				return
+			}
+			respParts, ok := responseParts(res)
+			if !ok {
+				continue
+			}
+			text := fmt.Sprintf("%s", respParts[0])
+			full.WriteString(text)
+			select {
+			case chunks <- text:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+		if full.Len() == 0 {
+			errCh <- errors.New("empty response from model")
+		}
+	}()
+
+	return chunks, errCh
+}
@@ -0,0 +1,14 @@
+package multimodal
+
+import "testing"
+
+func TestStartChatRejectsBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{text: "hello"})
+	chat, err := mm.StartChat(nil)
+	if err == nil {
+		t.Fatal("expected an error starting a chat against a configured Backend, got nil")
+	}
+	if chat != nil {
+		t.Errorf("expected a nil Chat on error, got %+v", chat)
+	}
+}
@@ -0,0 +1,103 @@
+package multimodal
+
+import "cloud.google.com/go/vertexai/genai"
+
+// SetSystemInstruction sets a system instruction that is sent alongside
+// every request, steering the model's behavior independently of the user
+// turn's parts.
+func (mm *MultiModal) SetSystemInstruction(text string) {
+	mm.systemInstruction = text
+}
+
+// SetSafetySetting adds or replaces the block threshold for one harm
+// category. Calling it again with the same category overrides the previous
+// threshold for that category.
+func (mm *MultiModal) SetSafetySetting(category genai.HarmCategory, threshold genai.HarmBlockThreshold) {
+	for _, setting := range mm.safetySettings {
+		if setting.Category == category {
+			setting.Threshold = threshold
+			return
+		}
+	}
+	mm.safetySettings = append(mm.safetySettings, &genai.SafetySetting{
+		Category:  category,
+		Threshold: threshold,
+	})
+}
+
+// SetTopK sets the top-K sampling parameter.
+func (mm *MultiModal) SetTopK(topK int32) {
+	mm.topK = &topK
+}
+
+// SetTopP sets the top-P (nucleus) sampling parameter.
+func (mm *MultiModal) SetTopP(topP float32) {
+	mm.topP = &topP
+}
+
+// SetMaxOutputTokens bounds how many tokens the model may generate.
+func (mm *MultiModal) SetMaxOutputTokens(maxOutputTokens int32) {
+	mm.maxOutputTokens = &maxOutputTokens
+}
+
+// SetCandidateCount sets how many candidate responses the model should
+// generate for each request.
+func (mm *MultiModal) SetCandidateCount(candidateCount int32) {
+	mm.candidateCount = &candidateCount
+}
+
+// SetStopSequences sets the sequences that, if generated, stop the model
+// from producing further output.
+func (mm *MultiModal) SetStopSequences(stopSequences []string) {
+	mm.stopSequences = stopSequences
+}
+
+// hasGenerationConfig reports whether any generation-config knob set via
+// SetSystemInstruction, SetSafetySetting, SetTopK, SetTopP,
+// SetMaxOutputTokens, SetCandidateCount or SetStopSequences has been
+// configured. SubmitToClient uses it to return an error when those settings
+// are combined with a configured Backend, which has nowhere to send them.
+// The streaming variants (SubmitStreamToClient, SubmitStream) do not check
+// it and send only the parts to the Backend.
+func (mm *MultiModal) hasGenerationConfig() bool {
+	return mm.systemInstruction != "" ||
+		len(mm.safetySettings) > 0 ||
+		mm.topK != nil ||
+		mm.topP != nil ||
+		mm.maxOutputTokens != nil ||
+		mm.candidateCount != nil ||
+		len(mm.stopSequences) > 0
+}
+
+// applyGenerationConfig applies every configured generation-config knob to
+// model, ahead of a GenerateContent call.
+func (mm *MultiModal) applyGenerationConfig(model *genai.GenerativeModel) {
+	model.SetTemperature(mm.temperature)
+	if mm.systemInstruction != "" {
+		model.SystemInstruction = genai.NewUserContent(genai.Text(mm.systemInstruction))
+	}
+	if len(mm.safetySettings) > 0 {
+		model.SafetySettings = mm.safetySettings
+	}
+	if mm.topK != nil {
+		model.SetTopK(*mm.topK)
+	}
+	if mm.topP != nil {
+		model.SetTopP(*mm.topP)
+	}
+	if mm.maxOutputTokens != nil {
+		model.SetMaxOutputTokens(*mm.maxOutputTokens)
+	}
+	if mm.candidateCount != nil {
+		model.SetCandidateCount(*mm.candidateCount)
+	}
+	if len(mm.stopSequences) > 0 {
+		model.StopSequences = mm.stopSequences
+	}
+	if mm.responseMIMEType != "" {
+		model.ResponseMIMEType = mm.responseMIMEType
+	}
+	if mm.responseSchema != nil {
+		model.ResponseSchema = mm.responseSchema
+	}
+}
@@ -0,0 +1,27 @@
+package multimodal
+
+import "testing"
+
+func TestHasGenerationConfig(t *testing.T) {
+	mm := New("test-model", 0.4)
+	if mm.hasGenerationConfig() {
+		t.Fatal("a freshly created MultiModal should report no generation config")
+	}
+
+	mm.SetSystemInstruction("be terse")
+	if !mm.hasGenerationConfig() {
+		t.Error("SetSystemInstruction should be reported by hasGenerationConfig")
+	}
+
+	mm = New("test-model", 0.4)
+	mm.SetTopK(10)
+	if !mm.hasGenerationConfig() {
+		t.Error("SetTopK should be reported by hasGenerationConfig")
+	}
+
+	mm = New("test-model", 0.4)
+	mm.SetStopSequences([]string{"STOP"})
+	if !mm.hasGenerationConfig() {
+		t.Error("SetStopSequences should be reported by hasGenerationConfig")
+	}
+}
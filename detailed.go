@@ -0,0 +1,83 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// SubmissionResult carries the model's response alongside the metadata
+// SubmitToClient discards, so callers can tell a safety block apart from a
+// genuinely empty response instead of just seeing "empty response from model".
+type SubmissionResult struct {
+	Text          string
+	FinishReason  genai.FinishReason
+	SafetyRatings []*genai.SafetyRating
+}
+
+// SubmitDetailedToClient behaves like SubmitToClient, but returns the
+// candidate's finish reason and safety ratings alongside the text. Tools
+// registered with RegisterTool are not sent with the request, so no
+// function-calling loop is run. Unlike
+// SubmitToClient, this is not supported when the MultiModal instance was
+// created with NewWithBackend, since the Backend interface only surfaces the
+// generated text, not finish reasons or safety ratings.
+func (mm *MultiModal) SubmitDetailedToClient(ctx context.Context, client *genai.Client) (detailed SubmissionResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+
+	if mm.backend != nil {
+		return SubmissionResult{}, errors.New("detailed submission is not supported with a configured Backend")
+	}
+
+	model := client.GenerativeModel(mm.modelName)
+	mm.applyGenerationConfig(model)
+
+	res, err := model.GenerateContent(ctx, mm.parts...)
+	if err != nil {
+		return SubmissionResult{}, fmt.Errorf("unable to generate contents: %v", err)
+	}
+	if res == nil || len(res.Candidates) == 0 || res.Candidates[0] == nil {
+		return SubmissionResult{}, errors.New("empty response from model")
+	}
+
+	candidate := res.Candidates[0]
+	detailed = SubmissionResult{
+		FinishReason:  candidate.FinishReason,
+		SafetyRatings: candidate.SafetyRatings,
+	}
+	if candidate.Content == nil || len(candidate.Content.Parts) == 0 {
+		return detailed, nil
+	}
+
+	text := fmt.Sprintf("%s\n", candidate.Content.Parts[0])
+	if mm.trim {
+		text = strings.TrimSpace(text)
+	}
+	detailed.Text = text
+	return detailed, nil
+}
+
+// SubmitDetailed creates a temporary client and behaves like Submit, but
+// returns the candidate's finish reason and safety ratings alongside the
+// text. This function is not meant to be used within Google Cloud (use
+// SubmitDetailedToClient instead).
+func (mm *MultiModal) SubmitDetailed(projectID, location string) (SubmissionResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mm.timeout)
+	defer cancel()
+	if mm.backend != nil {
+		return mm.SubmitDetailedToClient(ctx, nil)
+	}
+	client, err := genai.NewClient(ctx, projectID, location)
+	if err != nil {
+		return SubmissionResult{}, fmt.Errorf("unable to create client: %v", err)
+	}
+	defer client.Close()
+	return mm.SubmitDetailedToClient(ctx, client)
+}
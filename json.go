@@ -0,0 +1,78 @@
+package multimodal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// defaultMaxJSONRetries bounds how many times SubmitInto will ask the model
+// to correct malformed JSON before giving up.
+const defaultMaxJSONRetries = 2
+
+// SetResponseMIMEType constrains the model's output to a particular MIME
+// type, most commonly "application/json" for structured extraction.
+func (mm *MultiModal) SetResponseMIMEType(mimeType string) {
+	mm.responseMIMEType = mimeType
+}
+
+// SetResponseSchema constrains the model's JSON output to the given schema.
+// It only takes effect once SetResponseMIMEType("application/json") has also
+// been set.
+func (mm *MultiModal) SetResponseSchema(schema *genai.Schema) {
+	mm.responseSchema = schema
+}
+
+// SetMaxJSONRetries bounds how many times SubmitInto will add a JSON parse
+// error to the prompt and resubmit it before giving up.
+func (mm *MultiModal) SetMaxJSONRetries(n int) {
+	mm.maxJSONRetries = n
+}
+
+// SubmitInto submits mm's current parts, requiring a JSON response, and
+// unmarshals the model's reply into a value of type T. If the model's reply
+// is not valid JSON, a text part describing the parse error is appended to
+// mm's parts and the whole prompt is submitted again, up to mm's
+// MaxJSONRetries (2 by default). This is not a separate conversation turn:
+// the model's invalid reply is not included in the resubmitted prompt, and
+// the appended text parts remain in mm's parts after SubmitInto returns.
+//
+// SubmitInto is a standalone function rather than a method because Go does
+// not allow methods to introduce their own type parameters. It is not
+// supported when mm was created with NewWithBackend, since the Backend
+// interface has no way to request a response MIME type or schema, and
+// retrying against a backend that was never asked for JSON could not
+// possibly succeed.
+func SubmitInto[T any](mm *MultiModal, projectID, location string) (T, error) {
+	var result T
+
+	if mm.backend != nil {
+		return result, errors.New("structured output (SubmitInto) is not supported with a configured Backend")
+	}
+
+	if mm.responseMIMEType == "" {
+		mm.SetResponseMIMEType("application/json")
+	}
+
+	maxRetries := mm.maxJSONRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxJSONRetries
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		raw, err := mm.Submit(projectID, location)
+		if err != nil {
+			return result, err
+		}
+		if err := json.Unmarshal([]byte(raw), &result); err == nil {
+			return result, nil
+		} else {
+			lastErr = err
+			mm.AddText(fmt.Sprintf("Your previous reply was not valid JSON (%v). Reply again with only valid JSON matching the requested schema.", err))
+		}
+	}
+	return result, fmt.Errorf("model did not produce valid JSON after %d attempts: %v", maxRetries+1, lastErr)
+}
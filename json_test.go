@@ -0,0 +1,13 @@
+package multimodal
+
+import "testing"
+
+func TestSubmitIntoRejectsBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{text: `{"ok":true}`})
+	type result struct {
+		OK bool `json:"ok"`
+	}
+	if _, err := SubmitInto[result](mm, "project", "location"); err == nil {
+		t.Error("expected SubmitInto to reject a configured Backend, got nil error")
+	}
+}
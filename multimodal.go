@@ -19,19 +19,39 @@ import (
 
 // MultiModal represents multimodal prompt parts + configuration
 type MultiModal struct {
-	modelName   string
-	temperature float32
-	parts       []genai.Part
-	trim        bool
-	verbose     bool
-	timeout     time.Duration
+	modelName             string
+	temperature           float32
+	parts                 []genai.Part
+	trim                  bool
+	verbose               bool
+	timeout               time.Duration
+	tools                 []registeredTool
+	maxToolIterations     int
+	backend               Backend
+	responseMIMEType      string
+	responseSchema        *genai.Schema
+	maxJSONRetries        int
+	systemInstruction     string
+	safetySettings        []*genai.SafetySetting
+	topK                  *int32
+	topP                  *float32
+	maxOutputTokens       *int32
+	candidateCount        *int32
+	stopSequences         []string
+	tokenCountConcurrency int
 }
 
 // New creates a new MultiModal instance with a specified model name and temperature,
 // initializing it with default values for parts, trim, and verbose settings.
 func New(modelName string, temperature float32) *MultiModal {
-	parts := make([]genai.Part, 0)
-	return &MultiModal{modelName, 0.4, parts, true, false, 2 * time.Minute}
+	return &MultiModal{
+		modelName:   modelName,
+		temperature: temperature,
+		parts:       make([]genai.Part, 0),
+		trim:        true,
+		verbose:     false,
+		timeout:     2 * time.Minute,
+	}
 }
 
 func (mm *MultiModal) SetTimeout(timeout time.Duration) {
@@ -134,16 +154,27 @@ func (mm *MultiModal) CountTextTokensWithClient(ctx context.Context, client *gen
 	return int(resp.TotalTokens), nil
 }
 
-// CountTokensWithClient will count the tokens in the current multimodal prompt
+// CountTokensWithClient will count the tokens in the current multimodal prompt.
+// If the MultiModal instance was created with NewWithBackend, client is ignored
+// and the configured Backend is used instead. All parts are sent in a single
+// CountTokens RPC; if the API rejects that (some mixed part-type prompts
+// aren't accepted in one batch), it falls back to CountTokensDetailedWithClient,
+// which counts each part concurrently.
 func (mm *MultiModal) CountTokensWithClient(ctx context.Context, client *genai.Client) (int, error) {
+	if mm.backend != nil {
+		return mm.backend.CountTokens(ctx, mm.parts...)
+	}
 	model := client.GenerativeModel(mm.modelName)
+	if resp, err := model.CountTokens(ctx, mm.parts...); err == nil {
+		return int(resp.TotalTokens), nil
+	}
+	breakdown, err := mm.CountTokensDetailedWithClient(ctx, client)
+	if err != nil {
+		return 0, err
+	}
 	var sum int
-	for _, part := range mm.parts {
-		resp, err := model.CountTokens(ctx, part)
-		if err != nil {
-			return sum, err
-		}
-		sum += int(resp.TotalTokens)
+	for _, info := range breakdown {
+		sum += info.Tokens
 	}
 	return sum, nil
 }
@@ -164,28 +195,49 @@ func (mm *MultiModal) AddText(prompt string) {
 
 // SubmitToClient sends all added parts to the specified Vertex AI model for processing,
 // returning the model's response. It supports temperature configuration and response trimming.
+// If the MultiModal instance was created with NewWithBackend, client is ignored and the
+// configured Backend is used instead.
 func (mm *MultiModal) SubmitToClient(ctx context.Context, client *genai.Client) (result string, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic occurred: %v", r)
 		}
 	}()
+	if mm.backend != nil {
+		if len(mm.tools) > 0 {
+			return "", errors.New("tool calling is not supported with a configured Backend")
+		}
+		if mm.hasGenerationConfig() {
+			return "", errors.New("system instruction, safety settings and sampling parameters are not supported with a configured Backend")
+		}
+		result, err = mm.backend.GenerateContent(ctx, mm.parts...)
+		if err != nil {
+			return "", err
+		}
+		if mm.trim {
+			return strings.TrimSpace(result), nil
+		}
+		return result, nil
+	}
 	// Configure the model
 	model := client.GenerativeModel(mm.modelName)
-	model.SetTemperature(mm.temperature)
+	mm.applyGenerationConfig(model)
+	if len(mm.tools) > 0 {
+		model.Tools = []*genai.Tool{{FunctionDeclarations: mm.functionDeclarations()}}
+		return mm.submitWithTools(ctx, model)
+	}
 	// Then pass in the parts and generate a response
 	res, err := model.GenerateContent(ctx, mm.parts...)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate contents: %v", err)
 	}
 	// Then examine the response, defensively
-	if res == nil || len(res.Candidates) == 0 || res.Candidates[0] == nil ||
-		res.Candidates[0].Content == nil || res.Candidates[0].Content.Parts == nil ||
-		len(res.Candidates[0].Content.Parts) == 0 {
+	parts, ok := responseParts(res)
+	if !ok {
 		return "", errors.New("empty response from model")
 	}
 	// And return the result as a string
-	result = fmt.Sprintf("%s\n", res.Candidates[0].Content.Parts[0])
+	result = fmt.Sprintf("%s\n", parts[0])
 	if mm.trim {
 		return strings.TrimSpace(result), nil
 	}
@@ -198,6 +250,9 @@ func (mm *MultiModal) SubmitToClient(ctx context.Context, client *genai.Client)
 func (mm *MultiModal) Submit(projectID, location string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), mm.timeout)
 	defer cancel()
+	if mm.backend != nil {
+		return mm.SubmitToClient(ctx, nil)
+	}
 	client, err := genai.NewClient(ctx, projectID, location)
 	if err != nil {
 		return "", fmt.Errorf("unable to create client: %v", err)
@@ -210,6 +265,9 @@ func (mm *MultiModal) Submit(projectID, location string) (string, error) {
 func (mm *MultiModal) CountTokens(projectID, location string) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), mm.timeout)
 	defer cancel()
+	if mm.backend != nil {
+		return mm.CountTokensWithClient(ctx, nil)
+	}
 	client, err := genai.NewClient(ctx, projectID, location)
 	if err != nil {
 		return 0, fmt.Errorf("unable to create client: %v", err)
@@ -0,0 +1,38 @@
+package multimodal
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+func TestSubmitToClientUsesBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{text: "  hello  "})
+	mm.AddText("hi")
+	result, err := mm.SubmitToClient(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("SubmitToClient returned error: %v", err)
+	}
+	if result != "hello" {
+		t.Errorf("got %q, want trimmed %q", result, "hello")
+	}
+}
+
+func TestSubmitToClientRejectsToolsWithBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{text: "hello"})
+	mm.RegisterTool("noop", "does nothing", &genai.Schema{}, func(args map[string]any) (any, error) {
+		return nil, nil
+	})
+	if _, err := mm.SubmitToClient(context.Background(), nil); err == nil {
+		t.Error("expected an error when tools are registered against a Backend, got nil")
+	}
+}
+
+func TestSubmitToClientRejectsGenerationConfigWithBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{text: "hello"})
+	mm.SetSystemInstruction("be terse")
+	if _, err := mm.SubmitToClient(context.Background(), nil); err == nil {
+		t.Error("expected an error when generation config is set against a Backend, got nil")
+	}
+}
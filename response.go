@@ -0,0 +1,18 @@
+package multimodal
+
+import (
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// responseParts returns the parts of a GenerateContentResponse's first
+// candidate, and false if the response is empty or missing content. Every
+// Vertex AI call site in this package (Submit, stream, chat, tools, ...)
+// needs to guard against exactly this shape of empty response before
+// looking at the parts.
+func responseParts(res *genai.GenerateContentResponse) ([]genai.Part, bool) {
+	if res == nil || len(res.Candidates) == 0 || res.Candidates[0] == nil ||
+		res.Candidates[0].Content == nil || len(res.Candidates[0].Content.Parts) == 0 {
+		return nil, false
+	}
+	return res.Candidates[0].Content.Parts, true
+}
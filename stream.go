@@ -0,0 +1,162 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+	"google.golang.org/api/iterator"
+)
+
+// streamInto runs the actual GenerateContentStream loop, writing text chunks
+// to chunks and, if anything goes wrong, a single error to errCh. It does not
+// close either channel; the caller owns that, since the caller also owns the
+// lifetime of ctx and client.
+func (mm *MultiModal) streamInto(ctx context.Context, client *genai.Client, chunks chan<- string, errCh chan<- error) {
+	model := client.GenerativeModel(mm.modelName)
+	mm.applyGenerationConfig(model)
+
+	iter := model.GenerateContentStream(ctx, mm.parts...)
+	var full strings.Builder
+	for {
+		res, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			errCh <- fmt.Errorf("unable to generate contents: %v", err)
+			return
+		}
+		parts, ok := responseParts(res)
+		if !ok {
+			continue
+		}
+		text := fmt.Sprintf("%s", parts[0])
+		full.WriteString(text)
+		select {
+		case chunks <- text:
+		case <-ctx.Done():
+			errCh <- ctx.Err()
+			return
+		}
+	}
+	if full.Len() == 0 {
+		errCh <- errors.New("empty response from model")
+	}
+}
+
+// SubmitStreamToClient sends all added parts to the specified Vertex AI model
+// and streams back incremental text chunks as they are generated, instead of
+// blocking until the full response is ready. Both returned channels are
+// closed once the stream ends, either successfully or because of an error.
+// At most one error is ever sent on the error channel. If the MultiModal
+// instance was created with NewWithBackend, client is ignored and the
+// configured Backend is used instead.
+func (mm *MultiModal) SubmitStreamToClient(ctx context.Context, client *genai.Client) (<-chan string, <-chan error) {
+	if mm.backend != nil {
+		return mm.backend.StreamContent(ctx, mm.parts...)
+	}
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+		mm.streamInto(ctx, client, chunks, errCh)
+	}()
+	return chunks, errCh
+}
+
+// SubmitStream creates a temporary client and streams back incremental text
+// chunks as they are generated. This function is not meant to be used within
+// Google Cloud (use SubmitStreamToClient instead). The client and context
+// used for the request are cleaned up once the stream ends.
+func (mm *MultiModal) SubmitStream(projectID, location string) (<-chan string, <-chan error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mm.timeout)
+
+	if mm.backend != nil {
+		backendChunks, backendErrs := mm.backend.StreamContent(ctx, mm.parts...)
+		chunks := make(chan string)
+		errCh := make(chan error, 1)
+		go func() {
+			defer cancel()
+			defer close(chunks)
+			defer close(errCh)
+			for backendChunks != nil || backendErrs != nil {
+				select {
+				case chunk, ok := <-backendChunks:
+					if !ok {
+						backendChunks = nil
+						continue
+					}
+					chunks <- chunk
+				case err, ok := <-backendErrs:
+					if !ok {
+						backendErrs = nil
+						continue
+					}
+					errCh <- err
+				}
+			}
+		}()
+		return chunks, errCh
+	}
+
+	chunks := make(chan string)
+	errCh := make(chan error, 1)
+
+	client, err := genai.NewClient(ctx, projectID, location)
+	if err != nil {
+		cancel()
+		close(chunks)
+		errCh <- fmt.Errorf("unable to create client: %v", err)
+		close(errCh)
+		return chunks, errCh
+	}
+
+	go func() {
+		defer cancel()
+		defer client.Close()
+		defer close(chunks)
+		defer close(errCh)
+		mm.streamInto(ctx, client, chunks, errCh)
+	}()
+
+	return chunks, errCh
+}
+
+// SubmitStreamToClientWriter streams the model's response to the given
+// writer as it arrives, and returns the final assembled result (honoring the
+// trim flag) once the stream completes.
+func (mm *MultiModal) SubmitStreamToClientWriter(ctx context.Context, client *genai.Client, w io.Writer) (string, error) {
+	chunks, errCh := mm.SubmitStreamToClient(ctx, client)
+	var full strings.Builder
+	for chunks != nil || errCh != nil {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				chunks = nil
+				continue
+			}
+			full.WriteString(chunk)
+			if _, err := io.WriteString(w, chunk); err != nil {
+				return "", err
+			}
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	result := full.String()
+	if mm.trim {
+		return strings.TrimSpace(result), nil
+	}
+	return result, nil
+}
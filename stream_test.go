@@ -0,0 +1,23 @@
+package multimodal
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSubmitStreamToClientUsesBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{chunks: []string{"hel", "lo"}})
+	mm.AddText("hi")
+
+	chunks, errCh := mm.SubmitStreamToClient(context.Background(), nil)
+	var got string
+	for chunk := range chunks {
+		got += chunk
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("unexpected error from the stream: %v", err)
+	}
+	if got != "hello" {
+		t.Errorf("got %q, want %q", got, "hello")
+	}
+}
@@ -0,0 +1,133 @@
+package multimodal
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// PartTokenInfo reports the token count of a single part of the multimodal
+// prompt, so callers can see which image or text block is the expensive one.
+type PartTokenInfo struct {
+	Index    int
+	MIMEType string
+	Tokens   int
+}
+
+// SetTokenCountConcurrency bounds how many CountTokens RPCs
+// CountTokensDetailedWithClient may have in flight at once. It defaults to
+// runtime.GOMAXPROCS(0) when unset or set to zero or less.
+func (mm *MultiModal) SetTokenCountConcurrency(n int) {
+	mm.tokenCountConcurrency = n
+}
+
+// partMIMEType reports the MIME type of a part, for the PartTokenInfo
+// breakdown. Parts that don't carry one, such as plain text, are reported as
+// "text/plain".
+func partMIMEType(part genai.Part) string {
+	switch p := part.(type) {
+	case genai.Blob:
+		return p.MIMEType
+	case genai.FileData:
+		return p.MIMEType
+	default:
+		return "text/plain"
+	}
+}
+
+// CountTokensDetailedWithClient counts the tokens of each part of the
+// current multimodal prompt concurrently, using a worker pool bounded by
+// SetTokenCountConcurrency (default runtime.GOMAXPROCS(0)), and returns a
+// per-part breakdown. If the MultiModal instance was created with
+// NewWithBackend, client is ignored and each part is counted through the
+// configured Backend instead.
+func (mm *MultiModal) CountTokensDetailedWithClient(ctx context.Context, client *genai.Client) (result []PartTokenInfo, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+
+	concurrency := mm.tokenCountConcurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	var model *genai.GenerativeModel
+	if mm.backend == nil {
+		model = client.GenerativeModel(mm.modelName)
+	}
+	breakdown := make([]PartTokenInfo, len(mm.parts))
+
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, concurrency)
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for index, part := range mm.parts {
+		wg.Add(1)
+		go func(index int, part genai.Part) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			tokens, err := mm.countTokensForPart(ctx, model, part)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			breakdown[index] = PartTokenInfo{
+				Index:    index,
+				MIMEType: partMIMEType(part),
+				Tokens:   tokens,
+			}
+		}(index, part)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return breakdown, nil
+}
+
+// countTokensForPart counts the tokens of a single part, dispatching through
+// the configured Backend when there is one, or the given Vertex AI model
+// otherwise.
+func (mm *MultiModal) countTokensForPart(ctx context.Context, model *genai.GenerativeModel, part genai.Part) (int, error) {
+	if mm.backend != nil {
+		return mm.backend.CountTokens(ctx, part)
+	}
+	resp, err := model.CountTokens(ctx, part)
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.TotalTokens), nil
+}
+
+// CountTokensDetailed creates a temporary client and returns the per-part
+// token breakdown of the current multimodal prompt. This function is not
+// meant to be used within Google Cloud (use CountTokensDetailedWithClient
+// instead).
+func (mm *MultiModal) CountTokensDetailed(projectID, location string) ([]PartTokenInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mm.timeout)
+	defer cancel()
+	if mm.backend != nil {
+		return mm.CountTokensDetailedWithClient(ctx, nil)
+	}
+	client, err := genai.NewClient(ctx, projectID, location)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %v", err)
+	}
+	defer client.Close()
+	return mm.CountTokensDetailedWithClient(ctx, client)
+}
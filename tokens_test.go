@@ -0,0 +1,46 @@
+package multimodal
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+func TestPartMIMEType(t *testing.T) {
+	cases := []struct {
+		name string
+		in   genai.Part
+		want string
+	}{
+		{"text", genai.Text("hi"), "text/plain"},
+		{"blob", genai.Blob{MIMEType: "image/png"}, "image/png"},
+		{"file data", genai.FileData{MIMEType: "image/jpeg"}, "image/jpeg"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := partMIMEType(c.in); got != c.want {
+				t.Errorf("partMIMEType(%v) = %q, want %q", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCountTokensDetailedWithClientBackend(t *testing.T) {
+	mm := NewWithBackend("test-model", 0.4, &stubBackend{tokens: 5})
+	mm.AddText("hello")
+	mm.AddData("image/png", []byte{1, 2, 3})
+
+	breakdown, err := mm.CountTokensDetailedWithClient(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("CountTokensDetailedWithClient returned error: %v", err)
+	}
+	if len(breakdown) != 2 {
+		t.Fatalf("got %d entries, want 2", len(breakdown))
+	}
+	for _, info := range breakdown {
+		if info.Tokens != 5 {
+			t.Errorf("got %d tokens, want 5 for part %d", info.Tokens, info.Index)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package multimodal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+// defaultMaxToolIterations bounds the function-calling loop so that a model
+// that keeps requesting tool calls can never hang a request forever.
+const defaultMaxToolIterations = 8
+
+// ToolHandler is called when the model requests a function call. It
+// receives the arguments the model supplied and returns a result that is
+// sent back to the model as the function's response.
+type ToolHandler func(args map[string]any) (any, error)
+
+type registeredTool struct {
+	declaration *genai.FunctionDeclaration
+	handler     ToolHandler
+}
+
+// RegisterTool makes a function available for the model to call. name and
+// description are surfaced to the model as-is, params describes the
+// function's arguments, and handler is invoked with the arguments the model
+// chooses to pass whenever the model decides to call the tool.
+func (mm *MultiModal) RegisterTool(name, description string, params *genai.Schema, handler func(args map[string]any) (any, error)) {
+	mm.tools = append(mm.tools, registeredTool{
+		declaration: &genai.FunctionDeclaration{
+			Name:        name,
+			Description: description,
+			Parameters:  params,
+		},
+		handler: handler,
+	})
+}
+
+// SetMaxToolIterations bounds how many times the model may call a tool
+// before SubmitToClient gives up and returns an error, instead of looping
+// forever on a model that never produces a final text answer.
+func (mm *MultiModal) SetMaxToolIterations(n int) {
+	mm.maxToolIterations = n
+}
+
+func (mm *MultiModal) functionDeclarations() []*genai.FunctionDeclaration {
+	decls := make([]*genai.FunctionDeclaration, len(mm.tools))
+	for i, t := range mm.tools {
+		decls[i] = t.declaration
+	}
+	return decls
+}
+
+func (mm *MultiModal) dispatchToolCall(call genai.FunctionCall) (genai.Part, error) {
+	for _, t := range mm.tools {
+		if t.declaration.Name != call.Name {
+			continue
+		}
+		result, err := t.handler(call.Args)
+		if err != nil {
+			return genai.FunctionResponse{
+				Name: call.Name,
+				Response: map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+		response, ok := result.(map[string]any)
+		if !ok {
+			response = map[string]any{"result": result}
+		}
+		return genai.FunctionResponse{Name: call.Name, Response: response}, nil
+	}
+	return nil, fmt.Errorf("model requested unknown tool %q", call.Name)
+}
+
+// submitWithTools runs the request/response loop for function calling: it
+// sends the current parts, and for as long as the model replies with
+// FunctionCall parts instead of a final answer, dispatches them to the
+// registered handlers and feeds the FunctionResponse back, up to
+// MaxToolIterations rounds. The exchange is driven through a ChatSession so
+// that the model sees the full history (its own FunctionCall turns and our
+// FunctionResponse turns) on every round, rather than just the latest parts.
+func (mm *MultiModal) submitWithTools(ctx context.Context, model *genai.GenerativeModel) (result string, err error) {
+	maxIterations := mm.maxToolIterations
+	if maxIterations <= 0 {
+		maxIterations = defaultMaxToolIterations
+	}
+
+	session := model.StartChat()
+	parts := mm.parts
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		res, err := session.SendMessage(ctx, parts...)
+		if err != nil {
+			return "", fmt.Errorf("unable to generate contents: %v", err)
+		}
+		candidateParts, ok := responseParts(res)
+		if !ok {
+			return "", errors.New("empty response from model")
+		}
+
+		var calls []genai.FunctionCall
+		var text strings.Builder
+		for _, part := range candidateParts {
+			if call, ok := part.(genai.FunctionCall); ok {
+				calls = append(calls, call)
+				continue
+			}
+			fmt.Fprintf(&text, "%s", part)
+		}
+
+		if len(calls) == 0 {
+			result = text.String()
+			if mm.trim {
+				return strings.TrimSpace(result), nil
+			}
+			return result, nil
+		}
+
+		parts = nil
+		for _, call := range calls {
+			functionResponse, err := mm.dispatchToolCall(call)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, functionResponse)
+		}
+	}
+	return "", fmt.Errorf("exceeded MaxToolIterations (%d) without a final answer", maxIterations)
+}
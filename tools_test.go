@@ -0,0 +1,57 @@
+package multimodal
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/vertexai/genai"
+)
+
+func TestDispatchToolCall(t *testing.T) {
+	mm := New("test-model", 0.4)
+	mm.RegisterTool("add", "adds two numbers", &genai.Schema{}, func(args map[string]any) (any, error) {
+		return map[string]any{"sum": 3}, nil
+	})
+	mm.RegisterTool("fail", "always fails", &genai.Schema{}, func(args map[string]any) (any, error) {
+		return nil, errors.New("boom")
+	})
+
+	part, err := mm.dispatchToolCall(genai.FunctionCall{Name: "add"})
+	if err != nil {
+		t.Fatalf("dispatchToolCall returned error: %v", err)
+	}
+	resp, ok := part.(genai.FunctionResponse)
+	if !ok {
+		t.Fatalf("got part of type %T, want genai.FunctionResponse", part)
+	}
+	if resp.Name != "add" || resp.Response["sum"] != 3 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	part, err = mm.dispatchToolCall(genai.FunctionCall{Name: "fail"})
+	if err != nil {
+		t.Fatalf("dispatchToolCall returned error for a failing handler: %v", err)
+	}
+	resp, ok = part.(genai.FunctionResponse)
+	if !ok || resp.Response["error"] != "boom" {
+		t.Errorf("expected the handler error to be surfaced as a response, got %+v", part)
+	}
+
+	if _, err := mm.dispatchToolCall(genai.FunctionCall{Name: "missing"}); err == nil {
+		t.Error("expected an error for an unknown tool, got nil")
+	}
+}
+
+func TestFunctionDeclarations(t *testing.T) {
+	mm := New("test-model", 0.4)
+	mm.RegisterTool("a", "first", &genai.Schema{}, func(args map[string]any) (any, error) { return nil, nil })
+	mm.RegisterTool("b", "second", &genai.Schema{}, func(args map[string]any) (any, error) { return nil, nil })
+
+	decls := mm.functionDeclarations()
+	if len(decls) != 2 {
+		t.Fatalf("got %d declarations, want 2", len(decls))
+	}
+	if decls[0].Name != "a" || decls[1].Name != "b" {
+		t.Errorf("unexpected declaration order/names: %q, %q", decls[0].Name, decls[1].Name)
+	}
+}